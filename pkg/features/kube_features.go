@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package features
+
+import (
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/component-base/featuregate"
+)
+
+const (
+	// owner: sig-storage
+	// VolumeCapacityPriority enables scoring nodes by available PV capacity, with
+	// bigger nodes preferred, when a dynamically provisioned PVC has a non-specific storage class.
+	VolumeCapacityPriority featuregate.Feature = "VolumeCapacityPriority"
+
+	// owner: sig-scheduling
+	// BatchResourceFit enables the BatchResourceFit scheduler plugin, which
+	// filters and scores nodes based on reclaimed batch extended resources for
+	// colocating best-effort pods on overcommitted nodes.
+	BatchResourceFit featuregate.Feature = "BatchResourceFit"
+
+	// owner: sig-scheduling
+	// ElasticQuota enables the ElasticQuota scheduler plugin, which enforces a
+	// hierarchical tree of min/max quotas across namespaces.
+	ElasticQuota featuregate.Feature = "ElasticQuota"
+
+	// owner: sig-scheduling
+	// CoScheduling enables the CoScheduling (gang scheduling) plugin, which
+	// schedules the members of a PodGroup atomically.
+	CoScheduling featuregate.Feature = "CoScheduling"
+
+	// owner: sig-scheduling
+	// NodeResourceTopologyMatch enables the NodeResourceTopologyMatch plugin,
+	// which filters and scores nodes based on NUMA topology so that pods can
+	// be aligned to a single NUMA zone.
+	NodeResourceTopologyMatch featuregate.Feature = "NodeResourceTopologyMatch"
+)
+
+// defaultKubernetesFeatureGates consists of all known Kubernetes-specific feature keys
+// relevant to this package. To add a new feature, define a key for it above and add it
+// here.
+var defaultKubernetesFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
+	VolumeCapacityPriority:    {Default: false, PreRelease: featuregate.Alpha},
+	BatchResourceFit:          {Default: false, PreRelease: featuregate.Alpha},
+	ElasticQuota:              {Default: false, PreRelease: featuregate.Alpha},
+	CoScheduling:              {Default: false, PreRelease: featuregate.Alpha},
+	NodeResourceTopologyMatch: {Default: false, PreRelease: featuregate.Alpha},
+}
+
+func init() {
+	utilruntime.Must(feature.DefaultMutableFeatureGate.Add(defaultKubernetesFeatureGates))
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+const (
+	// DefaultPercentageOfNodesToScore is the default value for
+	// KubeSchedulerConfiguration.PercentageOfNodesToScore.
+	DefaultPercentageOfNodesToScore = 50
+
+	// MaxCustomPriorityScore is the max score UtilizationShapePoint expects.
+	MaxCustomPriorityScore int64 = 10
+)
+
+// ScoringStrategyType is the type of scoring strategy used by resource-based plugins.
+type ScoringStrategyType string
+
+const (
+	// LeastAllocated prefers nodes with the least amount of requested resources.
+	LeastAllocated ScoringStrategyType = "LeastAllocated"
+	// MostAllocated prefers nodes with the most amount of requested resources.
+	MostAllocated ScoringStrategyType = "MostAllocated"
+	// BalancedAllocation prefers nodes with balanced resource usage.
+	BalancedAllocation ScoringStrategyType = "BalancedAllocation"
+)
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	configv1 "k8s.io/kube-scheduler/config/v1"
+)
+
+// ConfigMapGetter resolves a single key of a ConfigMap to its string value.
+// It is satisfied by a thin wrapper around a clientset's
+// CoreV1().ConfigMaps(namespace).Get, kept as an interface here so this
+// package doesn't need to depend on a Kubernetes client directly.
+type ConfigMapGetter interface {
+	GetConfigMapKey(namespace, name, key string) (string, error)
+}
+
+// ResolveAlgorithmSource reads the scheduler profiles referenced by source's
+// File or ConfigMap, decodes them, and applies KubeSchedulerProfile defaults
+// to each. A source that carries neither (i.e. one defaulted to a named
+// Provider) resolves to no profiles, since named providers are expressed as
+// the default in-tree plugin set built by getDefaultPlugins rather than a
+// serialized profile list.
+//
+// ResolveAlgorithmSource must be called, and its result assigned to
+// KubeSchedulerConfiguration.Profiles, before SetDefaults_KubeSchedulerConfiguration
+// runs, so that remaining defaulting operates on the resolved profiles.
+func ResolveAlgorithmSource(source configv1.SchedulerAlgorithmSource, configMaps ConfigMapGetter) ([]configv1.KubeSchedulerProfile, error) {
+	var data string
+	switch {
+	case source.File != nil:
+		raw, err := os.ReadFile(source.File.Path)
+		if err != nil {
+			return nil, fmt.Errorf("reading scheduler algorithm source file %q: %w", source.File.Path, err)
+		}
+		data = string(raw)
+	case source.ConfigMap != nil:
+		if configMaps == nil {
+			return nil, fmt.Errorf("scheduler algorithm source is a ConfigMap but no ConfigMapGetter was provided")
+		}
+		var err error
+		data, err = configMaps.GetConfigMapKey(source.ConfigMap.Namespace, source.ConfigMap.Name, source.ConfigMap.Key)
+		if err != nil {
+			return nil, fmt.Errorf("reading scheduler algorithm source configMap %s/%s: %w", source.ConfigMap.Namespace, source.ConfigMap.Name, err)
+		}
+	default:
+		return nil, nil
+	}
+
+	var profiles []configv1.KubeSchedulerProfile
+	if err := json.Unmarshal([]byte(data), &profiles); err != nil {
+		return nil, fmt.Errorf("decoding scheduler algorithm source profiles: %w", err)
+	}
+	for i := range profiles {
+		setDefaults_KubeSchedulerProfile(&profiles[i])
+	}
+	return profiles, nil
+}
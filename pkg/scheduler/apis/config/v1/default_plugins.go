@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"k8s.io/apiserver/pkg/util/feature"
+	configv1 "k8s.io/kube-scheduler/config/v1"
+	"k8s.io/kubernetes/pkg/features"
+	"k8s.io/utils/pointer"
+)
+
+// getDefaultPlugins returns the default set of in-tree plugins, plus any
+// plugins gated behind an enabled feature.
+func getDefaultPlugins() *configv1.Plugins {
+	plugins := &configv1.Plugins{
+		MultiPoint: configv1.PluginSet{
+			Enabled: []configv1.Plugin{
+				{Name: "PrioritySort"},
+				{Name: "NodeUnschedulable"},
+				{Name: "NodeName"},
+				{Name: "TaintToleration", Weight: pointer.Int32(3)},
+				{Name: "NodeAffinity", Weight: pointer.Int32(2)},
+				{Name: "NodePorts"},
+				{Name: "NodeResourcesFit", Weight: pointer.Int32(1)},
+				{Name: "VolumeRestrictions"},
+				{Name: "NodeVolumeLimits"},
+				{Name: "VolumeBinding"},
+				{Name: "VolumeZone"},
+				{Name: "PodTopologySpread", Weight: pointer.Int32(2)},
+				{Name: "InterPodAffinity", Weight: pointer.Int32(2)},
+				{Name: "DefaultPreemption"},
+				{Name: "NodeResourcesBalancedAllocation", Weight: pointer.Int32(1)},
+				{Name: "ImageLocality", Weight: pointer.Int32(1)},
+				{Name: "DefaultBinder"},
+			},
+		},
+	}
+	applyFeatureGates(plugins)
+	return plugins
+}
+
+// applyFeatureGates appends plugins that are only enabled when their
+// corresponding feature gate is on, so that operators can opt in to them by
+// simply defaulting the KubeSchedulerConfiguration.
+func applyFeatureGates(plugins *configv1.Plugins) {
+	if feature.DefaultFeatureGate.Enabled(features.BatchResourceFit) {
+		plugins.Filter.Enabled = append(plugins.Filter.Enabled, configv1.Plugin{Name: "BatchResourceFit"})
+		plugins.Score.Enabled = append(plugins.Score.Enabled, configv1.Plugin{Name: "BatchResourceFit", Weight: pointer.Int32(1)})
+	}
+	if feature.DefaultFeatureGate.Enabled(features.ElasticQuota) {
+		plugins.PreFilter.Enabled = append(plugins.PreFilter.Enabled, configv1.Plugin{Name: "ElasticQuota"})
+		plugins.Filter.Enabled = append(plugins.Filter.Enabled, configv1.Plugin{Name: "ElasticQuota"})
+		plugins.PostFilter.Enabled = append(plugins.PostFilter.Enabled, configv1.Plugin{Name: "ElasticQuota"})
+		plugins.Reserve.Enabled = append(plugins.Reserve.Enabled, configv1.Plugin{Name: "ElasticQuota"})
+	}
+	if feature.DefaultFeatureGate.Enabled(features.CoScheduling) {
+		// Only one QueueSort plugin may be enabled per profile, so CoScheduling's
+		// gang-aware sort replaces the default PrioritySort rather than stacking
+		// alongside it.
+		plugins.MultiPoint.Enabled = removePlugin(plugins.MultiPoint.Enabled, "PrioritySort")
+		plugins.QueueSort.Enabled = append(plugins.QueueSort.Enabled, configv1.Plugin{Name: "CoScheduling"})
+		plugins.PreFilter.Enabled = append(plugins.PreFilter.Enabled, configv1.Plugin{Name: "CoScheduling"})
+		plugins.Permit.Enabled = append(plugins.Permit.Enabled, configv1.Plugin{Name: "CoScheduling"})
+		plugins.PostFilter.Enabled = append(plugins.PostFilter.Enabled, configv1.Plugin{Name: "CoScheduling"})
+	}
+	if feature.DefaultFeatureGate.Enabled(features.NodeResourceTopologyMatch) {
+		plugins.Filter.Enabled = append(plugins.Filter.Enabled, configv1.Plugin{Name: "NodeResourceTopologyMatch"})
+		plugins.Score.Enabled = append(plugins.Score.Enabled, configv1.Plugin{Name: "NodeResourceTopologyMatch", Weight: pointer.Int32(1)})
+	}
+}
+
+// removePlugin returns enabled with any plugin named name dropped.
+func removePlugin(enabled []configv1.Plugin, name string) []configv1.Plugin {
+	filtered := enabled[:0]
+	for _, p := range enabled {
+		if p.Name != name {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered
+}
+
+// mergePlugins merges the custom plugins from a KubeSchedulerProfile with
+// the default plugin set. The custom Disabled list is applied first
+// (supporting the special name "*" to disable all default plugins for that
+// extension point), then custom Enabled plugins are appended after the
+// surviving defaults.
+func mergePlugins(defaultPlugins, customPlugins *configv1.Plugins) *configv1.Plugins {
+	if customPlugins == nil {
+		return defaultPlugins
+	}
+
+	defaultPlugins.QueueSort = mergePluginSet(defaultPlugins.QueueSort, customPlugins.QueueSort)
+	defaultPlugins.PreFilter = mergePluginSet(defaultPlugins.PreFilter, customPlugins.PreFilter)
+	defaultPlugins.Filter = mergePluginSet(defaultPlugins.Filter, customPlugins.Filter)
+	defaultPlugins.PostFilter = mergePluginSet(defaultPlugins.PostFilter, customPlugins.PostFilter)
+	defaultPlugins.PreScore = mergePluginSet(defaultPlugins.PreScore, customPlugins.PreScore)
+	defaultPlugins.Score = mergePluginSet(defaultPlugins.Score, customPlugins.Score)
+	defaultPlugins.Reserve = mergePluginSet(defaultPlugins.Reserve, customPlugins.Reserve)
+	defaultPlugins.Permit = mergePluginSet(defaultPlugins.Permit, customPlugins.Permit)
+	defaultPlugins.PreBind = mergePluginSet(defaultPlugins.PreBind, customPlugins.PreBind)
+	defaultPlugins.Bind = mergePluginSet(defaultPlugins.Bind, customPlugins.Bind)
+	defaultPlugins.PostBind = mergePluginSet(defaultPlugins.PostBind, customPlugins.PostBind)
+	defaultPlugins.MultiPoint = mergePluginSet(defaultPlugins.MultiPoint, customPlugins.MultiPoint)
+
+	return defaultPlugins
+}
+
+func mergePluginSet(defaultSet, customSet configv1.PluginSet) configv1.PluginSet {
+	disabledNames := map[string]bool{}
+	for _, disabled := range customSet.Disabled {
+		disabledNames[disabled.Name] = true
+	}
+
+	var merged []configv1.Plugin
+	if !disabledNames["*"] {
+		for _, p := range defaultSet.Enabled {
+			if !disabledNames[p.Name] {
+				merged = append(merged, p)
+			}
+		}
+	}
+
+	existing := map[string]bool{}
+	for _, p := range merged {
+		existing[p.Name] = true
+	}
+	for _, p := range customSet.Enabled {
+		if !existing[p.Name] {
+			merged = append(merged, p)
+			existing[p.Name] = true
+		}
+	}
+
+	return configv1.PluginSet{Enabled: merged}
+}
@@ -17,7 +17,10 @@ limitations under the License.
 package v1
 
 import (
+	"time"
+
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/util/feature"
@@ -33,10 +36,29 @@ var defaultResourceSpec = []configv1.ResourceSpec{
 	{Name: string(v1.ResourceMemory), Weight: 1},
 }
 
+// defaultBatchResourceSpec lists the reclaimed "batch" extended resources
+// advertised by a node agent for colocating best-effort pods on top of
+// overcommitted latency-sensitive workloads.
+var defaultBatchResourceSpec = []configv1.ResourceSpec{
+	{Name: "kubernetes.io/batch-cpu", Weight: 1},
+	{Name: "kubernetes.io/batch-memory", Weight: 1},
+}
+
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
 
+// hasCoSchedulingPlugin reports whether any profile enables the CoScheduling
+// (gang scheduling) plugin.
+func hasCoSchedulingPlugin(profiles []configv1.KubeSchedulerProfile) bool {
+	for i := range profiles {
+		if sets.NewString(pluginsNames(profiles[i].Plugins)...).Has("CoScheduling") {
+			return true
+		}
+	}
+	return false
+}
+
 func pluginsNames(p *configv1.Plugins) []string {
 	if p == nil {
 		return nil
@@ -99,8 +121,16 @@ func setDefaults_KubeSchedulerProfile(prof *configv1.KubeSchedulerProfile) {
 	}
 }
 
+// defaultAlgorithmProviderName is the algorithm source provider used when a
+// KubeSchedulerConfiguration doesn't specify a Provider, File, or ConfigMap.
+const defaultAlgorithmProviderName = "DefaultProvider"
+
 // SetDefaults_KubeSchedulerConfiguration sets additional defaults
 func SetDefaults_KubeSchedulerConfiguration(obj *configv1.KubeSchedulerConfiguration) {
+	if obj.AlgorithmSource.Provider == nil && obj.AlgorithmSource.File == nil && obj.AlgorithmSource.ConfigMap == nil {
+		obj.AlgorithmSource.Provider = pointer.String(defaultAlgorithmProviderName)
+	}
+
 	if obj.Parallelism == nil {
 		obj.Parallelism = pointer.Int32Ptr(16)
 	}
@@ -152,7 +182,13 @@ func SetDefaults_KubeSchedulerConfiguration(obj *configv1.KubeSchedulerConfigura
 	componentbaseconfigv1alpha1.RecommendedDefaultLeaderElectionConfiguration(&obj.LeaderElection)
 
 	if obj.PodInitialBackoffSeconds == nil {
-		obj.PodInitialBackoffSeconds = pointer.Int64(1)
+		if hasCoSchedulingPlugin(obj.Profiles) {
+			// Grouped pods scheduled one at a time thrash the backoff queue while
+			// the rest of their gang waits on Permit, so start with a longer backoff.
+			obj.PodInitialBackoffSeconds = pointer.Int64(5)
+		} else {
+			obj.PodInitialBackoffSeconds = pointer.Int64(1)
+		}
 	}
 
 	if obj.PodMaxBackoffSeconds == nil {
@@ -239,3 +275,92 @@ func SetDefaults_NodeResourcesFitArgs(obj *configv1.NodeResourcesFitArgs) {
 		}
 	}
 }
+
+// SetDefaults_BatchResourceFitArgs sets the default parameters for the
+// BatchResourceFit plugin, which filters and scores nodes based on the
+// reclaimed batch resources a node agent has advertised for colocating
+// best-effort pods alongside overcommitted latency-sensitive workloads.
+func SetDefaults_BatchResourceFitArgs(obj *configv1.BatchResourceFitArgs) {
+	if obj.ScoringStrategy == nil {
+		obj.ScoringStrategy = &configv1.ScoringStrategy{
+			Type:      configv1.ScoringStrategyType(config.LeastAllocated),
+			Resources: defaultBatchResourceSpec,
+		}
+	}
+	if len(obj.ScoringStrategy.Resources) == 0 {
+		// If no resources specified, use the default batch resource set.
+		obj.ScoringStrategy.Resources = append(obj.ScoringStrategy.Resources, defaultBatchResourceSpec...)
+	}
+	for i := range obj.ScoringStrategy.Resources {
+		if obj.ScoringStrategy.Resources[i].Weight == 0 {
+			obj.ScoringStrategy.Resources[i].Weight = 1
+		}
+	}
+	if obj.ScoreThreshold == nil {
+		// Nodes whose batch resource utilization exceeds this percentage are filtered out.
+		obj.ScoreThreshold = pointer.Int64(85)
+	}
+}
+
+// SetDefaults_ElasticQuotaArgs sets the default parameters for the
+// ElasticQuota plugin, which enforces a hierarchical tree of min/max quotas
+// and preempts pods belonging to quota groups that are over their max in
+// favor of groups that are under their min.
+func SetDefaults_ElasticQuotaArgs(obj *configv1.ElasticQuotaArgs) {
+	if obj.RootQuotaName == "" {
+		obj.RootQuotaName = "root"
+	}
+	if obj.EnableRuntimeQuotaCalculation == nil {
+		obj.EnableRuntimeQuotaCalculation = pointer.Bool(true)
+	}
+	if obj.MonitorAllQuotas == nil {
+		obj.MonitorAllQuotas = pointer.Bool(false)
+	}
+	if obj.RevokePodsInterval == nil {
+		obj.RevokePodsInterval = &metav1.Duration{Duration: 60 * time.Second}
+	}
+	if obj.DelayedEvictionGracePeriod == nil {
+		obj.DelayedEvictionGracePeriod = &metav1.Duration{Duration: 120 * time.Second}
+	}
+}
+
+// SetDefaults_CoSchedulingArgs sets the default parameters for the
+// CoScheduling (gang) plugin, which schedules the members of a PodGroup
+// atomically instead of one at a time.
+func SetDefaults_CoSchedulingArgs(obj *configv1.CoSchedulingArgs) {
+	if obj.DefaultTimeout == nil {
+		obj.DefaultTimeout = &metav1.Duration{Duration: 60 * time.Second}
+	}
+	if obj.ScheduleTimeoutSeconds == nil {
+		obj.ScheduleTimeoutSeconds = pointer.Int64(60)
+	}
+	if obj.InheritOwnerAnnotations == nil {
+		// Propagate annotations from the owning Job/CRD to PodGroups auto-created for it.
+		obj.InheritOwnerAnnotations = pointer.Bool(true)
+	}
+}
+
+// SetDefaults_NodeResourceTopologyMatchArgs sets the default parameters for
+// the NodeResourceTopologyMatch plugin, which filters and scores nodes based
+// on NodeResourceTopology CRs published by a per-node agent so that pods can
+// be placed within a single NUMA zone.
+func SetDefaults_NodeResourceTopologyMatchArgs(obj *configv1.NodeResourceTopologyMatchArgs) {
+	if obj.ScoringStrategy == nil {
+		obj.ScoringStrategy = &configv1.ScoringStrategy{
+			Type:      configv1.ScoringStrategyType(config.LeastAllocated),
+			Resources: defaultResourceSpec,
+		}
+	}
+	if len(obj.ScoringStrategy.Resources) == 0 {
+		// If no resources specified, use the default set.
+		obj.ScoringStrategy.Resources = append(obj.ScoringStrategy.Resources, defaultResourceSpec...)
+	}
+	for i := range obj.ScoringStrategy.Resources {
+		if obj.ScoringStrategy.Resources[i].Weight == 0 {
+			obj.ScoringStrategy.Resources[i].Weight = 1
+		}
+	}
+	if obj.TopologyManagerPolicy == "" {
+		obj.TopologyManagerPolicy = configv1.SingleNUMANodePodLevel
+	}
+}
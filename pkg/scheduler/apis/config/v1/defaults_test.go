@@ -0,0 +1,271 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	configv1 "k8s.io/kube-scheduler/config/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestSetDefaults_DefaultPreemptionArgs(t *testing.T) {
+	obj := &configv1.DefaultPreemptionArgs{}
+	SetDefaults_DefaultPreemptionArgs(obj)
+	want := &configv1.DefaultPreemptionArgs{
+		MinCandidateNodesPercentage: pointer.Int32(10),
+		MinCandidateNodesAbsolute:   pointer.Int32(100),
+	}
+	if diff := cmp.Diff(want, obj); diff != "" {
+		t.Errorf("unexpected defaults (-want +got):\n%s", diff)
+	}
+}
+
+func TestSetDefaults_InterPodAffinityArgs(t *testing.T) {
+	obj := &configv1.InterPodAffinityArgs{}
+	SetDefaults_InterPodAffinityArgs(obj)
+	want := &configv1.InterPodAffinityArgs{HardPodAffinityWeight: pointer.Int32(1)}
+	if diff := cmp.Diff(want, obj); diff != "" {
+		t.Errorf("unexpected defaults (-want +got):\n%s", diff)
+	}
+}
+
+func TestSetDefaults_BatchResourceFitArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  *configv1.BatchResourceFitArgs
+		want *configv1.BatchResourceFitArgs
+	}{
+		{
+			name: "empty args get the default batch resource spec",
+			obj:  &configv1.BatchResourceFitArgs{},
+			want: &configv1.BatchResourceFitArgs{
+				ScoringStrategy: &configv1.ScoringStrategy{
+					Type:      configv1.ScoringStrategyType("LeastAllocated"),
+					Resources: defaultBatchResourceSpec,
+				},
+				ScoreThreshold: pointer.Int64(85),
+			},
+		},
+		{
+			name: "explicit weight of zero is defaulted to one",
+			obj: &configv1.BatchResourceFitArgs{
+				ScoringStrategy: &configv1.ScoringStrategy{
+					Resources: []configv1.ResourceSpec{{Name: "kubernetes.io/batch-cpu", Weight: 0}},
+				},
+			},
+			want: &configv1.BatchResourceFitArgs{
+				ScoringStrategy: &configv1.ScoringStrategy{
+					Resources: []configv1.ResourceSpec{{Name: "kubernetes.io/batch-cpu", Weight: 1}},
+				},
+				ScoreThreshold: pointer.Int64(85),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			SetDefaults_BatchResourceFitArgs(tc.obj)
+			if diff := cmp.Diff(tc.want, tc.obj); diff != "" {
+				t.Errorf("unexpected defaults (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetDefaults_ElasticQuotaArgs(t *testing.T) {
+	obj := &configv1.ElasticQuotaArgs{}
+	SetDefaults_ElasticQuotaArgs(obj)
+
+	if obj.RootQuotaName != "root" {
+		t.Errorf("expected RootQuotaName to default to %q, got %q", "root", obj.RootQuotaName)
+	}
+	if obj.EnableRuntimeQuotaCalculation == nil || !*obj.EnableRuntimeQuotaCalculation {
+		t.Errorf("expected EnableRuntimeQuotaCalculation to default to true")
+	}
+	if obj.MonitorAllQuotas == nil || *obj.MonitorAllQuotas {
+		t.Errorf("expected MonitorAllQuotas to default to false")
+	}
+	if obj.RevokePodsInterval == nil || obj.RevokePodsInterval.Duration.Seconds() != 60 {
+		t.Errorf("expected RevokePodsInterval to default to 60s, got %v", obj.RevokePodsInterval)
+	}
+	if obj.DelayedEvictionGracePeriod == nil || obj.DelayedEvictionGracePeriod.Duration.Seconds() != 120 {
+		t.Errorf("expected DelayedEvictionGracePeriod to default to 120s, got %v", obj.DelayedEvictionGracePeriod)
+	}
+}
+
+func TestSetDefaults_CoSchedulingArgs(t *testing.T) {
+	obj := &configv1.CoSchedulingArgs{}
+	SetDefaults_CoSchedulingArgs(obj)
+
+	if obj.DefaultTimeout == nil || obj.DefaultTimeout.Duration.Seconds() != 60 {
+		t.Errorf("expected DefaultTimeout to default to 60s, got %v", obj.DefaultTimeout)
+	}
+	if obj.ScheduleTimeoutSeconds == nil || *obj.ScheduleTimeoutSeconds != 60 {
+		t.Errorf("expected ScheduleTimeoutSeconds to default to 60, got %v", obj.ScheduleTimeoutSeconds)
+	}
+	if obj.InheritOwnerAnnotations == nil || !*obj.InheritOwnerAnnotations {
+		t.Errorf("expected InheritOwnerAnnotations to default to true")
+	}
+}
+
+func TestSetDefaults_NodeResourceTopologyMatchArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  *configv1.NodeResourceTopologyMatchArgs
+		want *configv1.NodeResourceTopologyMatchArgs
+	}{
+		{
+			name: "empty args get the default resource spec and policy",
+			obj:  &configv1.NodeResourceTopologyMatchArgs{},
+			want: &configv1.NodeResourceTopologyMatchArgs{
+				ScoringStrategy: &configv1.ScoringStrategy{
+					Type:      configv1.ScoringStrategyType("LeastAllocated"),
+					Resources: defaultResourceSpec,
+				},
+				TopologyManagerPolicy: configv1.SingleNUMANodePodLevel,
+			},
+		},
+		{
+			name: "explicit policy and weight of zero are preserved and defaulted respectively",
+			obj: &configv1.NodeResourceTopologyMatchArgs{
+				ScoringStrategy: &configv1.ScoringStrategy{
+					Resources: []configv1.ResourceSpec{{Name: "cpu", Weight: 0}},
+				},
+				TopologyManagerPolicy: configv1.Restricted,
+			},
+			want: &configv1.NodeResourceTopologyMatchArgs{
+				ScoringStrategy: &configv1.ScoringStrategy{
+					Resources: []configv1.ResourceSpec{{Name: "cpu", Weight: 1}},
+				},
+				TopologyManagerPolicy: configv1.Restricted,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			SetDefaults_NodeResourceTopologyMatchArgs(tc.obj)
+			if diff := cmp.Diff(tc.want, tc.obj); diff != "" {
+				t.Errorf("unexpected defaults (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type fakeConfigMapGetter map[string]string
+
+func (f fakeConfigMapGetter) GetConfigMapKey(namespace, name, key string) (string, error) {
+	if v, ok := f[namespace+"/"+name+"/"+key]; ok {
+		return v, nil
+	}
+	return "", os.ErrNotExist
+}
+
+func TestResolveAlgorithmSource(t *testing.T) {
+	dir := t.TempDir()
+	policyPath := filepath.Join(dir, "policy.json")
+	if err := os.WriteFile(policyPath, []byte(`[{"schedulerName":"from-file"}]`), 0644); err != nil {
+		t.Fatalf("writing test policy file: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		source     configv1.SchedulerAlgorithmSource
+		configMaps ConfigMapGetter
+		wantNames  []string
+		wantErr    bool
+	}{
+		{
+			name:   "provider resolves to no profiles",
+			source: configv1.SchedulerAlgorithmSource{Provider: pointer.String("DefaultProvider")},
+		},
+		{
+			name:      "file resolves and defaults its profiles",
+			source:    configv1.SchedulerAlgorithmSource{File: &configv1.SchedulerAlgorithmSourceFile{Path: policyPath}},
+			wantNames: []string{"from-file"},
+		},
+		{
+			name: "configMap resolves via the getter",
+			source: configv1.SchedulerAlgorithmSource{ConfigMap: &configv1.SchedulerAlgorithmSourceConfigMap{
+				Namespace: "kube-system", Name: "scheduler-policy", Key: "policy.json",
+			}},
+			configMaps: fakeConfigMapGetter{"kube-system/scheduler-policy/policy.json": `[{"schedulerName":"from-configmap"}]`},
+			wantNames:  []string{"from-configmap"},
+		},
+		{
+			name:    "configMap without a getter errors",
+			source:  configv1.SchedulerAlgorithmSource{ConfigMap: &configv1.SchedulerAlgorithmSourceConfigMap{Namespace: "kube-system", Name: "scheduler-policy", Key: "policy.json"}},
+			wantErr: true,
+		},
+		{
+			name:    "missing file errors",
+			source:  configv1.SchedulerAlgorithmSource{File: &configv1.SchedulerAlgorithmSourceFile{Path: filepath.Join(dir, "missing.json")}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			profiles, err := ResolveAlgorithmSource(tc.source, tc.configMaps)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ResolveAlgorithmSource() err = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			var gotNames []string
+			for _, p := range profiles {
+				gotNames = append(gotNames, *p.SchedulerName)
+			}
+			if diff := cmp.Diff(tc.wantNames, gotNames); diff != "" {
+				t.Errorf("unexpected profile names (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHasCoSchedulingPlugin(t *testing.T) {
+	tests := []struct {
+		name     string
+		profiles []configv1.KubeSchedulerProfile
+		want     bool
+	}{
+		{name: "no profiles", want: false},
+		{
+			name: "plugin enabled via MultiPoint",
+			profiles: []configv1.KubeSchedulerProfile{
+				{Plugins: &configv1.Plugins{MultiPoint: configv1.PluginSet{Enabled: []configv1.Plugin{{Name: "CoScheduling"}}}}},
+			},
+			want: true,
+		},
+		{
+			name: "plugin not enabled",
+			profiles: []configv1.KubeSchedulerProfile{
+				{Plugins: &configv1.Plugins{Filter: configv1.PluginSet{Enabled: []configv1.Plugin{{Name: "NodeResourcesFit"}}}}},
+			},
+			want: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := hasCoSchedulingPlugin(tc.profiles); got != tc.want {
+				t.Errorf("hasCoSchedulingPlugin() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
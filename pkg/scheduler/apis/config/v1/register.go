@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	configv1 "k8s.io/kube-scheduler/config/v1"
+)
+
+var (
+	pluginArgConversionScheme     *runtime.Scheme
+	pluginArgConversionSchemeOnce sync.Once
+)
+
+// GetPluginArgConversionScheme returns a scheme used to default and convert
+// each plugin's PluginConfig.Args when it isn't set explicitly in a profile.
+func GetPluginArgConversionScheme() *runtime.Scheme {
+	pluginArgConversionSchemeOnce.Do(func() {
+		pluginArgConversionScheme = runtime.NewScheme()
+		pluginArgConversionScheme.AddKnownTypes(configv1.SchemeGroupVersion,
+			&configv1.DefaultPreemptionArgs{},
+			&configv1.InterPodAffinityArgs{},
+			&configv1.NodeResourcesBalancedAllocationArgs{},
+			&configv1.NodeResourcesFitArgs{},
+			&configv1.PodTopologySpreadArgs{},
+			&configv1.VolumeBindingArgs{},
+			&configv1.BatchResourceFitArgs{},
+			&configv1.ElasticQuotaArgs{},
+			&configv1.CoSchedulingArgs{},
+			&configv1.NodeResourceTopologyMatchArgs{},
+		)
+		utilruntime.Must(RegisterDefaults(pluginArgConversionScheme))
+	})
+	return pluginArgConversionScheme
+}
+
+// RegisterDefaults registers this package's defaulting functions with scheme.
+func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&configv1.DefaultPreemptionArgs{}, func(obj interface{}) {
+		SetDefaults_DefaultPreemptionArgs(obj.(*configv1.DefaultPreemptionArgs))
+	})
+	scheme.AddTypeDefaultingFunc(&configv1.InterPodAffinityArgs{}, func(obj interface{}) {
+		SetDefaults_InterPodAffinityArgs(obj.(*configv1.InterPodAffinityArgs))
+	})
+	scheme.AddTypeDefaultingFunc(&configv1.NodeResourcesBalancedAllocationArgs{}, func(obj interface{}) {
+		SetDefaults_NodeResourcesBalancedAllocationArgs(obj.(*configv1.NodeResourcesBalancedAllocationArgs))
+	})
+	scheme.AddTypeDefaultingFunc(&configv1.NodeResourcesFitArgs{}, func(obj interface{}) {
+		SetDefaults_NodeResourcesFitArgs(obj.(*configv1.NodeResourcesFitArgs))
+	})
+	scheme.AddTypeDefaultingFunc(&configv1.PodTopologySpreadArgs{}, func(obj interface{}) {
+		SetDefaults_PodTopologySpreadArgs(obj.(*configv1.PodTopologySpreadArgs))
+	})
+	scheme.AddTypeDefaultingFunc(&configv1.VolumeBindingArgs{}, func(obj interface{}) {
+		SetDefaults_VolumeBindingArgs(obj.(*configv1.VolumeBindingArgs))
+	})
+	scheme.AddTypeDefaultingFunc(&configv1.KubeSchedulerConfiguration{}, func(obj interface{}) {
+		SetDefaults_KubeSchedulerConfiguration(obj.(*configv1.KubeSchedulerConfiguration))
+	})
+	scheme.AddTypeDefaultingFunc(&configv1.BatchResourceFitArgs{}, func(obj interface{}) {
+		SetDefaults_BatchResourceFitArgs(obj.(*configv1.BatchResourceFitArgs))
+	})
+	scheme.AddTypeDefaultingFunc(&configv1.ElasticQuotaArgs{}, func(obj interface{}) {
+		SetDefaults_ElasticQuotaArgs(obj.(*configv1.ElasticQuotaArgs))
+	})
+	scheme.AddTypeDefaultingFunc(&configv1.CoSchedulingArgs{}, func(obj interface{}) {
+		SetDefaults_CoSchedulingArgs(obj.(*configv1.CoSchedulingArgs))
+	})
+	scheme.AddTypeDefaultingFunc(&configv1.NodeResourceTopologyMatchArgs{}, func(obj interface{}) {
+		SetDefaults_NodeResourceTopologyMatchArgs(obj.(*configv1.NodeResourceTopologyMatchArgs))
+	})
+	return nil
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation validates KubeSchedulerConfiguration fields that can't
+// be fully checked by the structural schema, such as fields whose
+// constraints depend on one another.
+package validation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	configv1 "k8s.io/kube-scheduler/config/v1"
+)
+
+// ValidateAlgorithmSource validates that source specifies exactly one of
+// Provider, File, or ConfigMap, and that the chosen source's required
+// sub-fields are populated.
+func ValidateAlgorithmSource(source configv1.SchedulerAlgorithmSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	set := 0
+	if source.Provider != nil {
+		set++
+	}
+	if source.File != nil {
+		set++
+	}
+	if source.ConfigMap != nil {
+		set++
+	}
+	switch {
+	case set == 0:
+		allErrs = append(allErrs, field.Required(fldPath, "exactly one of provider, file, or configMap must be set"))
+		return allErrs
+	case set > 1:
+		allErrs = append(allErrs, field.Invalid(fldPath, source, "provider, file, and configMap are mutually exclusive"))
+		return allErrs
+	}
+
+	if source.File != nil && len(source.File.Path) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("file", "path"), ""))
+	}
+	if source.ConfigMap != nil {
+		cmPath := fldPath.Child("configMap")
+		if len(source.ConfigMap.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(cmPath.Child("namespace"), ""))
+		}
+		if len(source.ConfigMap.Name) == 0 {
+			allErrs = append(allErrs, field.Required(cmPath.Child("name"), ""))
+		}
+		if len(source.ConfigMap.Key) == 0 {
+			allErrs = append(allErrs, field.Required(cmPath.Child("key"), ""))
+		}
+	}
+
+	return allErrs
+}
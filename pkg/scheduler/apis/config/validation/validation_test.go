@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	configv1 "k8s.io/kube-scheduler/config/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestValidateAlgorithmSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  configv1.SchedulerAlgorithmSource
+		wantErr bool
+	}{
+		{
+			name:   "provider set",
+			source: configv1.SchedulerAlgorithmSource{Provider: pointer.String("DefaultProvider")},
+		},
+		{
+			name:   "file set",
+			source: configv1.SchedulerAlgorithmSource{File: &configv1.SchedulerAlgorithmSourceFile{Path: "/etc/kubernetes/scheduler-policy.json"}},
+		},
+		{
+			name: "configMap set",
+			source: configv1.SchedulerAlgorithmSource{ConfigMap: &configv1.SchedulerAlgorithmSourceConfigMap{
+				Namespace: "kube-system", Name: "scheduler-policy", Key: "policy.json",
+			}},
+		},
+		{
+			name:    "none set",
+			source:  configv1.SchedulerAlgorithmSource{},
+			wantErr: true,
+		},
+		{
+			name: "provider and file both set",
+			source: configv1.SchedulerAlgorithmSource{
+				Provider: pointer.String("DefaultProvider"),
+				File:     &configv1.SchedulerAlgorithmSourceFile{Path: "/etc/kubernetes/scheduler-policy.json"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "file set with empty path",
+			source:  configv1.SchedulerAlgorithmSource{File: &configv1.SchedulerAlgorithmSourceFile{}},
+			wantErr: true,
+		},
+		{
+			name:    "configMap set with missing fields",
+			source:  configv1.SchedulerAlgorithmSource{ConfigMap: &configv1.SchedulerAlgorithmSourceConfigMap{}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateAlgorithmSource(tc.source, field.NewPath("algorithmSource"))
+			if gotErr := len(errs) > 0; gotErr != tc.wantErr {
+				t.Errorf("ValidateAlgorithmSource() errs = %v, wantErr %v", errs, tc.wantErr)
+			}
+		})
+	}
+}
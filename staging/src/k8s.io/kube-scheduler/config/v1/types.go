@@ -0,0 +1,338 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+)
+
+// GroupName is the group name used in this API.
+const GroupName = "kubescheduler.config.k8s.io"
+
+// SchemeGroupVersion is group version used to register these objects.
+var SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: "v1"}
+
+var (
+	// SchemeBuilder is the scheme builder for this API group/version.
+	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
+	// AddToScheme is a reference to SchemeBuilder.AddToScheme.
+	AddToScheme = SchemeBuilder.AddToScheme
+)
+
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemeGroupVersion,
+		&KubeSchedulerConfiguration{},
+		&DefaultPreemptionArgs{},
+		&InterPodAffinityArgs{},
+		&NodeResourcesBalancedAllocationArgs{},
+		&NodeResourcesFitArgs{},
+		&PodTopologySpreadArgs{},
+		&VolumeBindingArgs{},
+		&BatchResourceFitArgs{},
+		&ElasticQuotaArgs{},
+		&CoSchedulingArgs{},
+		&NodeResourceTopologyMatchArgs{},
+	)
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	return nil
+}
+
+const (
+	// SchedulerDefaultLockObjectNamespace is the default namespace for the leader election lock object.
+	SchedulerDefaultLockObjectNamespace = "kube-system"
+	// SchedulerDefaultLockObjectName is the default name for the leader election lock object.
+	SchedulerDefaultLockObjectName = "kube-scheduler"
+)
+
+// DefaultingType determines how plugin defaulting is performed.
+type DefaultingType string
+
+const (
+	// SystemDefaulting applies the component's built-in defaults.
+	SystemDefaulting DefaultingType = "System"
+	// ListDefaulting only applies the defaulting to fields in a provided list.
+	ListDefaulting DefaultingType = "List"
+)
+
+// ScoringStrategyType is the type of scoring strategy used by resource-based plugins.
+type ScoringStrategyType string
+
+const (
+	// LeastAllocated prefers nodes with the least amount of requested resources.
+	LeastAllocated ScoringStrategyType = "LeastAllocated"
+	// MostAllocated prefers nodes with the most amount of requested resources.
+	MostAllocated ScoringStrategyType = "MostAllocated"
+	// BalancedAllocation prefers nodes with balanced resource usage.
+	BalancedAllocation ScoringStrategyType = "BalancedAllocation"
+)
+
+// ResourceSpec names a resource and provides its scoring weight.
+type ResourceSpec struct {
+	Name   string `json:"name"`
+	Weight int64  `json:"weight,omitempty"`
+}
+
+// ScoringStrategy selects the function used to score nodes based on resource usage.
+type ScoringStrategy struct {
+	Type      ScoringStrategyType `json:"type,omitempty"`
+	Resources []ResourceSpec      `json:"resources,omitempty"`
+}
+
+// Plugin specifies a plugin name and its weight when applicable.
+type Plugin struct {
+	Name   string `json:"name"`
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// PluginSet specifies enabled and disabled plugins for an extension point.
+type PluginSet struct {
+	Enabled  []Plugin `json:"enabled,omitempty"`
+	Disabled []Plugin `json:"disabled,omitempty"`
+}
+
+// Plugins include multiple extension points.
+type Plugins struct {
+	QueueSort  PluginSet `json:"queueSort,omitempty"`
+	PreFilter  PluginSet `json:"preFilter,omitempty"`
+	Filter     PluginSet `json:"filter,omitempty"`
+	PostFilter PluginSet `json:"postFilter,omitempty"`
+	PreScore   PluginSet `json:"preScore,omitempty"`
+	Score      PluginSet `json:"score,omitempty"`
+	Reserve    PluginSet `json:"reserve,omitempty"`
+	Permit     PluginSet `json:"permit,omitempty"`
+	PreBind    PluginSet `json:"preBind,omitempty"`
+	Bind       PluginSet `json:"bind,omitempty"`
+	PostBind   PluginSet `json:"postBind,omitempty"`
+	MultiPoint PluginSet `json:"multiPoint,omitempty"`
+}
+
+// PluginConfig specifies arguments for a plugin named Name.
+type PluginConfig struct {
+	Name string               `json:"name"`
+	Args runtime.RawExtension `json:"args,omitempty"`
+}
+
+// KubeSchedulerProfile is a scheduling profile.
+type KubeSchedulerProfile struct {
+	SchedulerName *string        `json:"schedulerName,omitempty"`
+	Plugins       *Plugins       `json:"plugins,omitempty"`
+	PluginConfig  []PluginConfig `json:"pluginConfig,omitempty"`
+}
+
+// SchedulerAlgorithmSource is the source of a scheduler algorithm. One source
+// field must be specified, and source fields are mutually exclusive.
+type SchedulerAlgorithmSource struct {
+	// Provider is the name of a scheduling algorithm provider to use.
+	Provider *string `json:"provider,omitempty"`
+	// File is a file containing a scheduler config.
+	File *SchedulerAlgorithmSourceFile `json:"file,omitempty"`
+	// ConfigMap is a config map containing a scheduler config.
+	ConfigMap *SchedulerAlgorithmSourceConfigMap `json:"configMap,omitempty"`
+}
+
+// SchedulerAlgorithmSourceFile is a file that contains the scheduler profiles.
+type SchedulerAlgorithmSourceFile struct {
+	// Path is the location of a file containing the scheduler's profiles.
+	Path string `json:"path"`
+}
+
+// SchedulerAlgorithmSourceConfigMap is a config map that contains the scheduler profiles.
+type SchedulerAlgorithmSourceConfigMap struct {
+	// Namespace is the namespace of the config map.
+	Namespace string `json:"namespace"`
+	// Name is the name of the config map.
+	Name string `json:"name"`
+	// Key is the key in the config map holding the serialized profiles.
+	Key string `json:"key"`
+}
+
+// KubeSchedulerConfiguration configures a scheduler.
+type KubeSchedulerConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Parallelism defines the amount of parallelism in algorithms for scheduling a Pod.
+	Parallelism *int32 `json:"parallelism,omitempty"`
+
+	// AlgorithmSource specifies the scheduler algorithm source. A policy file,
+	// a policy ConfigMap, or a named provider may be used to configure the
+	// scheduler. Only one source should be set.
+	AlgorithmSource SchedulerAlgorithmSource `json:"algorithmSource,omitempty"`
+
+	// LeaderElection defines the configuration of leader election client.
+	LeaderElection componentbaseconfigv1alpha1.LeaderElectionConfiguration `json:"leaderElection"`
+
+	// ClientConnection specifies the kubeconfig file and client connection settings for the scheduler.
+	ClientConnection componentbaseconfigv1alpha1.ClientConnectionConfiguration `json:"clientConnection"`
+
+	// EnableProfiling enables profiling via web interface host:port/debug/pprof/.
+	EnableProfiling *bool `json:"enableProfiling,omitempty"`
+
+	// EnableContentionProfiling enables block profiling, if EnableProfiling is true.
+	EnableContentionProfiling *bool `json:"enableContentionProfiling,omitempty"`
+
+	// PercentageOfNodesToScore is the percentage of all nodes that once found feasible
+	// for running a pod, the scheduler stops its search for more feasible nodes.
+	PercentageOfNodesToScore *int32 `json:"percentageOfNodesToScore,omitempty"`
+
+	// PodInitialBackoffSeconds is the initial backoff for unschedulable pods.
+	PodInitialBackoffSeconds *int64 `json:"podInitialBackoffSeconds,omitempty"`
+
+	// PodMaxBackoffSeconds is the max backoff for unschedulable pods.
+	PodMaxBackoffSeconds *int64 `json:"podMaxBackoffSeconds,omitempty"`
+
+	// Profiles are scheduling profiles that kube-scheduler supports.
+	Profiles []KubeSchedulerProfile `json:"profiles,omitempty"`
+}
+
+// DefaultPreemptionArgs holds arguments used to configure the DefaultPreemption plugin.
+type DefaultPreemptionArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	MinCandidateNodesPercentage *int32 `json:"minCandidateNodesPercentage,omitempty"`
+	MinCandidateNodesAbsolute   *int32 `json:"minCandidateNodesAbsolute,omitempty"`
+}
+
+// InterPodAffinityArgs holds arguments used to configure the InterPodAffinity plugin.
+type InterPodAffinityArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	HardPodAffinityWeight *int32 `json:"hardPodAffinityWeight,omitempty"`
+}
+
+// NodeResourcesBalancedAllocationArgs holds arguments used to configure the
+// NodeResourcesBalancedAllocation plugin.
+type NodeResourcesBalancedAllocationArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Resources []ResourceSpec `json:"resources,omitempty"`
+}
+
+// NodeResourcesFitArgs holds arguments used to configure the NodeResourcesFit plugin.
+type NodeResourcesFitArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	ScoringStrategy *ScoringStrategy `json:"scoringStrategy,omitempty"`
+}
+
+// PodTopologySpreadArgs holds arguments used to configure the PodTopologySpread plugin.
+type PodTopologySpreadArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	DefaultConstraints []v1.TopologySpreadConstraint `json:"defaultConstraints,omitempty"`
+	DefaultingType     DefaultingType                `json:"defaultingType,omitempty"`
+}
+
+// UtilizationShapePoint is a single point describing a resource usage scoring function.
+type UtilizationShapePoint struct {
+	Utilization int32 `json:"utilization"`
+	Score       int32 `json:"score"`
+}
+
+// VolumeBindingArgs holds arguments used to configure the VolumeBinding plugin.
+type VolumeBindingArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	BindTimeoutSeconds *int64                  `json:"bindTimeoutSeconds,omitempty"`
+	Shape              []UtilizationShapePoint `json:"shape,omitempty"`
+}
+
+// BatchResourceFitArgs holds arguments used to configure the BatchResourceFit
+// plugin, which filters and scores nodes based on reclaimed "batch" extended
+// resources advertised by a node agent for colocating best-effort pods on
+// top of overcommitted latency-sensitive workloads.
+type BatchResourceFitArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// ScoringStrategy selects the batch resources considered and how nodes are scored.
+	ScoringStrategy *ScoringStrategy `json:"scoringStrategy,omitempty"`
+	// ScoreThreshold is the batch resource utilization percentage (0-100) above
+	// which a node is filtered out rather than merely scored lower.
+	ScoreThreshold *int64 `json:"scoreThreshold,omitempty"`
+}
+
+// ElasticQuotaArgs holds arguments used to configure the ElasticQuota plugin,
+// which enforces a hierarchical tree of min/max quotas and preempts pods in
+// over-max quota groups in favor of under-min quota groups.
+type ElasticQuotaArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// RootQuotaName is the name of the root of the quota hierarchy.
+	RootQuotaName string `json:"rootQuotaName,omitempty"`
+	// EnableRuntimeQuotaCalculation enables recomputing each quota's runtime
+	// (usable) limit from its siblings' min/max instead of using max directly.
+	EnableRuntimeQuotaCalculation *bool `json:"enableRuntimeQuotaCalculation,omitempty"`
+	// MonitorAllQuotas causes quotas without an explicit namespace mapping to
+	// still be tracked and reported.
+	MonitorAllQuotas *bool `json:"monitorAllQuotas,omitempty"`
+	// RevokePodsInterval is how often the plugin reconciles pods that should be
+	// evicted to bring over-max quota groups back into compliance.
+	RevokePodsInterval *metav1.Duration `json:"revokePodsInterval,omitempty"`
+	// DelayedEvictionGracePeriod is how long a pod selected for eviction is
+	// given before it's actually evicted.
+	DelayedEvictionGracePeriod *metav1.Duration `json:"delayedEvictionGracePeriod,omitempty"`
+}
+
+// CoSchedulingArgs holds arguments used to configure the CoScheduling plugin,
+// which schedules the members of a PodGroup atomically.
+type CoSchedulingArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// DefaultTimeout is how long a PodGroup without an explicit timeout is allowed to wait in Permit.
+	DefaultTimeout *metav1.Duration `json:"defaultTimeout,omitempty"`
+	// ScheduleTimeoutSeconds is the default minimum wait enforced in Permit even once minMember is met.
+	ScheduleTimeoutSeconds *int64 `json:"scheduleTimeoutSeconds,omitempty"`
+	// InheritOwnerAnnotations propagates annotations from a Job/CRD owner to the
+	// PodGroup auto-created on its behalf.
+	InheritOwnerAnnotations *bool `json:"inheritOwnerAnnotations,omitempty"`
+}
+
+// TopologyManagerPolicy is the topology manager policy a node is expected to
+// run with. It determines how strictly a node's reported NUMA topology must
+// match a pod's resource request for the node to be considered a fit.
+type TopologyManagerPolicy string
+
+const (
+	// SingleNUMANodePodLevel admits a node only if all of a pod's containers
+	// can be aligned to a single NUMA node.
+	SingleNUMANodePodLevel TopologyManagerPolicy = "SingleNUMANodePodLevel"
+	// SingleNUMANodeContainerLevel admits a node only if each container can
+	// individually be aligned to a single NUMA node.
+	SingleNUMANodeContainerLevel TopologyManagerPolicy = "SingleNUMANodeContainerLevel"
+	// Restricted admits a node only if some NUMA affinity is available,
+	// without requiring alignment to a single NUMA node.
+	Restricted TopologyManagerPolicy = "Restricted"
+	// BestEffort admits a node even when no NUMA affinity is available.
+	BestEffort TopologyManagerPolicy = "BestEffort"
+)
+
+// NodeResourceTopologyMatchArgs holds arguments used to configure the
+// NodeResourceTopologyMatch plugin, which filters and scores nodes based on
+// NodeResourceTopology CRs published by a per-node agent so that pods can be
+// aligned to a single NUMA zone.
+type NodeResourceTopologyMatchArgs struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// ScoringStrategy selects the resources considered and how nodes are scored.
+	ScoringStrategy *ScoringStrategy `json:"scoringStrategy,omitempty"`
+	// TopologyManagerPolicy is the topology manager policy that nodes are
+	// expected to run with.
+	TopologyManagerPolicy TopologyManagerPolicy `json:"topologyManagerPolicy,omitempty"`
+}